@@ -0,0 +1,40 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/iam"
+)
+
+// Config holds the provider-level settings supplied via the schema.Provider
+// configuration block and used to build an AWSClient.
+type Config struct {
+	IamConcurrency int
+}
+
+// AWSClient holds the service connections and provider-level settings
+// shared by resources in this package.
+type AWSClient struct {
+	iamconn *iam.IAM
+
+	// iamConcurrency bounds the number of concurrent AddUserToGroup/
+	// RemoveUserFromGroup calls issued by the aws_iam_group_membership
+	// resource; aws_iam_user_group_membership issues its calls one at a time.
+	iamConcurrency int
+}
+
+// Client builds an AWSClient from the provider Config.
+func (c *Config) Client() (*AWSClient, error) {
+	sess := session.Must(session.NewSession())
+
+	concurrency := c.IamConcurrency
+	if concurrency < 1 {
+		concurrency = defaultIamGroupMembershipConcurrency
+	}
+
+	client := &AWSClient{
+		iamconn:        iam.New(sess),
+		iamConcurrency: concurrency,
+	}
+
+	return client, nil
+}
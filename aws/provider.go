@@ -0,0 +1,34 @@
+package aws
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// Provider returns the schema.Provider backing this package.
+func Provider() *schema.Provider {
+	return &schema.Provider{
+		Schema: map[string]*schema.Schema{
+			"iam_concurrency": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     defaultIamGroupMembershipConcurrency,
+				Description: "Maximum number of concurrent IAM group membership API calls (AddUserToGroup/RemoveUserFromGroup) issued per batch.",
+			},
+		},
+
+		ResourcesMap: map[string]*schema.Resource{
+			"aws_iam_group_membership":      resourceAwsIamGroupMembership(),
+			"aws_iam_user_group_membership": resourceAwsIamUserGroupMembership(),
+		},
+
+		ConfigureFunc: providerConfigure,
+	}
+}
+
+func providerConfigure(d *schema.ResourceData) (interface{}, error) {
+	config := Config{
+		IamConcurrency: d.Get("iam_concurrency").(int),
+	}
+
+	return config.Client()
+}
@@ -2,15 +2,24 @@ package aws
 
 import (
 	"fmt"
+	"sort"
+	"sync"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/go-multierror"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
+// defaultIamGroupMembershipConcurrency is the default value of the
+// provider-level iam_concurrency setting (see Provider in provider.go),
+// used when it is left unconfigured.
+const defaultIamGroupMembershipConcurrency = 10
+
 func resourceAwsIamGroupMembership() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsIamGroupMembershipCreate,
@@ -40,17 +49,53 @@ func resourceAwsIamGroupMembership() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+
+			"group_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"group_unique_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"user_details": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
 func resourceAwsIamGroupMembershipCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	client := meta.(*AWSClient)
+	conn := client.iamconn
 
 	group := d.Get("group").(string)
 	userList := expandStringList(d.Get("users").(*schema.Set).List())
 
-	if err := addUsersToGroup(conn, userList, group); err != nil {
+	if err := addUsersToGroup(conn, userList, group, client.iamConcurrency); err != nil {
 		return err
 	}
 
@@ -63,44 +108,67 @@ func resourceAwsIamGroupMembershipRead(d *schema.ResourceData, meta interface{})
 	group := d.Get("group").(string)
 
 	var ul []string
-	var marker *string
-	for {
-		resp, err := conn.GetGroup(&iam.GetGroupInput{
-			GroupName: aws.String(group),
-			Marker:    marker,
-		})
-
-		if err != nil {
-			if awsErr, ok := err.(awserr.Error); ok {
-				// aws specific error
-				if awsErr.Code() == "NoSuchEntity" {
-					// group not found
-					d.SetId("")
-					return nil
-				}
-			}
-			return err
+	var userDetails []map[string]interface{}
+	var groupInfo *iam.Group
+	err := conn.GetGroupPages(&iam.GetGroupInput{
+		GroupName: aws.String(group),
+	}, func(page *iam.GetGroupOutput, lastPage bool) bool {
+		if groupInfo == nil {
+			groupInfo = page.Group
 		}
 
-		for _, u := range resp.Users {
+		for _, u := range page.Users {
 			ul = append(ul, *u.UserName)
+			userDetails = append(userDetails, map[string]interface{}{
+				"name":    aws.StringValue(u.UserName),
+				"arn":     aws.StringValue(u.Arn),
+				"user_id": aws.StringValue(u.UserId),
+				"path":    aws.StringValue(u.Path),
+			})
 		}
-
-		if !*resp.IsTruncated {
-			break
+		return !lastPage
+	})
+
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "NoSuchEntity" {
+			// group not found
+			d.SetId("")
+			return nil
 		}
-		marker = resp.Marker
+		return err
 	}
 
 	if err := d.Set("users", ul); err != nil {
 		return fmt.Errorf("Error setting user list from IAM Group Membership (%s), error: %s", group, err)
 	}
 
+	if groupInfo != nil {
+		if err := d.Set("group_arn", groupInfo.Arn); err != nil {
+			return fmt.Errorf("Error setting group_arn from IAM Group Membership (%s), error: %s", group, err)
+		}
+
+		if err := d.Set("group_unique_id", groupInfo.GroupId); err != nil {
+			return fmt.Errorf("Error setting group_unique_id from IAM Group Membership (%s), error: %s", group, err)
+		}
+	}
+
+	// IAM does not guarantee a stable ordering for group membership listings,
+	// so sort by name to keep this purely computed attribute from flapping
+	// between refreshes with no underlying membership change.
+	sort.Slice(userDetails, func(i, j int) bool {
+		return userDetails[i]["name"].(string) < userDetails[j]["name"].(string)
+	})
+
+	if err := d.Set("user_details", userDetails); err != nil {
+		return fmt.Errorf("Error setting user_details from IAM Group Membership (%s), error: %s", group, err)
+	}
+
 	return nil
 }
 
 func resourceAwsIamGroupMembershipUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	client := meta.(*AWSClient)
+	conn := client.iamconn
 
 	if d.HasChange("users") {
 		group := d.Get("group").(string)
@@ -118,11 +186,11 @@ func resourceAwsIamGroupMembershipUpdate(d *schema.ResourceData, meta interface{
 		remove := expandStringList(os.Difference(ns).List())
 		add := expandStringList(ns.Difference(os).List())
 
-		if err := removeUsersFromGroup(conn, remove, group); err != nil {
+		if err := removeUsersFromGroup(conn, remove, group, client.iamConcurrency); err != nil {
 			return err
 		}
 
-		if err := addUsersToGroup(conn, add, group); err != nil {
+		if err := addUsersToGroup(conn, add, group, client.iamConcurrency); err != nil {
 			return err
 		}
 	}
@@ -131,16 +199,99 @@ func resourceAwsIamGroupMembershipUpdate(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsIamGroupMembershipDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	client := meta.(*AWSClient)
 	userList := expandStringList(d.Get("users").(*schema.Set).List())
 	group := d.Get("group").(string)
 
-	err := removeUsersFromGroup(conn, userList, group)
-	return err
+	return removeUsersFromGroup(client.iamconn, userList, group, client.iamConcurrency)
+}
+
+// iamGroupMembershipRetryableErrorCodes lists the IAM/STS error codes that
+// are safe to retry with backoff when batching AddUserToGroup/
+// RemoveUserFromGroup calls: request throttling, exceeded service limits
+// and ServiceUnavailable. Other transient 5xx responses are handled in
+// isIamGroupMembershipRetryableError since they aren't always surfaced
+// under one of these fixed codes.
+var iamGroupMembershipRetryableErrorCodes = []string{
+	"Throttling",
+	"ThrottlingException",
+	"LimitExceeded",
+	"LimitExceededException",
+	"ServiceUnavailable",
+	"RequestLimitExceeded",
 }
 
-func removeUsersFromGroup(conn *iam.IAM, users []*string, group string) error {
+// isIamGroupMembershipRetryableError reports whether err is safe to retry:
+// either one of iamGroupMembershipRetryableErrorCodes, or any request that
+// failed with an HTTP 5xx status, regardless of its error code.
+func isIamGroupMembershipRetryableError(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	if !ok {
+		return false
+	}
+
+	for _, code := range iamGroupMembershipRetryableErrorCodes {
+		if awsErr.Code() == code {
+			return true
+		}
+	}
+
+	if reqErr, ok := err.(awserr.RequestFailure); ok && reqErr.StatusCode() >= 500 {
+		return true
+	}
+
+	return false
+}
+
+// batchIamGroupMembership fans work out across a bounded worker pool of
+// size concurrency, retrying each call on throttling/limit/5xx errors with
+// exponential backoff, and aggregates per-user failures into a single
+// *multierror.Error instead of returning on the first one.
+func batchIamGroupMembership(users []*string, concurrency int, fn func(u *string) error) error {
+	if concurrency < 1 {
+		concurrency = defaultIamGroupMembershipConcurrency
+	}
+
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs *multierror.Error
+		sem  = make(chan struct{}, concurrency)
+	)
+
 	for _, u := range users {
+		u := u
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+				if err := fn(u); err != nil {
+					if isIamGroupMembershipRetryableError(err) {
+						return resource.RetryableError(err)
+					}
+					return resource.NonRetryableError(err)
+				}
+				return nil
+			})
+
+			if err != nil {
+				mu.Lock()
+				errs = multierror.Append(errs, fmt.Errorf("%s: %s", *u, err))
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+	return errs.ErrorOrNil()
+}
+
+func removeUsersFromGroup(conn *iam.IAM, users []*string, group string, concurrency int) error {
+	return batchIamGroupMembership(users, concurrency, func(u *string) error {
 		_, err := conn.RemoveUserFromGroup(&iam.RemoveUserFromGroupInput{
 			UserName:  u,
 			GroupName: aws.String(group),
@@ -152,22 +303,18 @@ func removeUsersFromGroup(conn *iam.IAM, users []*string, group string) error {
 			}
 			return err
 		}
-	}
-	return nil
+		return nil
+	})
 }
 
-func addUsersToGroup(conn *iam.IAM, users []*string, group string) error {
-	for _, u := range users {
+func addUsersToGroup(conn *iam.IAM, users []*string, group string, concurrency int) error {
+	return batchIamGroupMembership(users, concurrency, func(u *string) error {
 		_, err := conn.AddUserToGroup(&iam.AddUserToGroupInput{
 			UserName:  u,
 			GroupName: aws.String(group),
 		})
-
-		if err != nil {
-			return err
-		}
-	}
-	return nil
+		return err
+	})
 }
 
 func resourceAwsIamGroupMembershipImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
@@ -0,0 +1,154 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccAWSIamGroupMembership_basic(t *testing.T) {
+	var group iam.GetGroupOutput
+
+	rString := acctest.RandString(8)
+	groupName := fmt.Sprintf("tf-acc-group-gm-basic-%s", rString)
+	userName := fmt.Sprintf("tf-acc-user-gm-basic-%s", rString)
+	membershipName := fmt.Sprintf("tf-acc-membership-gm-basic-%s", rString)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGroupMembershipDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGroupMemberConfig(groupName, userName, membershipName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGroupMembershipExists("aws_iam_group_membership.team", &group),
+					resource.TestCheckResourceAttr("aws_iam_group_membership.team", "users.#", "1"),
+					resource.TestCheckResourceAttrSet("aws_iam_group_membership.team", "group_arn"),
+					resource.TestCheckResourceAttrSet("aws_iam_group_membership.team", "group_unique_id"),
+					resource.TestCheckResourceAttr("aws_iam_group_membership.team", "user_details.#", "1"),
+					resource.TestCheckResourceAttrSet("aws_iam_group_membership.team", "user_details.0.arn"),
+					resource.TestCheckResourceAttrSet("aws_iam_group_membership.team", "user_details.0.user_id"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccAWSIamGroupMembership_paginatedUserList exercises the GetGroupPages
+// paginator in resourceAwsIamGroupMembershipRead against a group with more
+// members than fit on a single IAM GetGroup page.
+func TestAccAWSIamGroupMembership_paginatedUserList(t *testing.T) {
+	var group iam.GetGroupOutput
+
+	rString := acctest.RandString(8)
+	groupName := fmt.Sprintf("tf-acc-group-gm-pag-%s", rString)
+	membershipName := fmt.Sprintf("tf-acc-membership-gm-pag-%s", rString)
+	userCount := 101
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGroupMembershipDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGroupMemberConfigPaginatedUserList(groupName, membershipName, userCount),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGroupMembershipExists("aws_iam_group_membership.team", &group),
+					resource.TestCheckResourceAttr("aws_iam_group_membership.team", "users.#", fmt.Sprintf("%d", userCount)),
+					resource.TestCheckResourceAttr("aws_iam_group_membership.team", "user_details.#", fmt.Sprintf("%d", userCount)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSGroupMembershipDestroy(s *terraform.State) error {
+	iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iam_group_membership" {
+			continue
+		}
+
+		group := rs.Primary.Attributes["group"]
+
+		_, err := iamconn.GetGroup(&iam.GetGroupInput{
+			GroupName: aws.String(group),
+		})
+		if err != nil {
+			if isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
+				continue
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSGroupMembershipExists(n string, g *iam.GetGroupOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+		resp, err := iamconn.GetGroup(&iam.GetGroupInput{
+			GroupName: aws.String(rs.Primary.Attributes["group"]),
+		})
+		if err != nil {
+			return err
+		}
+
+		*g = *resp
+		return nil
+	}
+}
+
+func testAccAWSGroupMemberConfig(groupName, userName, membershipName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_group" "group" {
+  name = %[1]q
+}
+
+resource "aws_iam_user" "user" {
+  name = %[2]q
+}
+
+resource "aws_iam_group_membership" "team" {
+  name  = %[3]q
+  group = aws_iam_group.group.name
+  users = [aws_iam_user.user.name]
+}
+`, groupName, userName, membershipName)
+}
+
+func testAccAWSGroupMemberConfigPaginatedUserList(groupName, membershipName string, userCount int) string {
+	return fmt.Sprintf(`
+resource "aws_iam_group" "group" {
+  name = %[1]q
+}
+
+resource "aws_iam_user" "user" {
+  count = %[3]d
+  name  = "tf-acc-user-gm-pag-${count.index}"
+}
+
+resource "aws_iam_group_membership" "team" {
+  name  = %[2]q
+  group = aws_iam_group.group.name
+  users = aws_iam_user.user.*.name
+}
+`, groupName, membershipName, userCount)
+}
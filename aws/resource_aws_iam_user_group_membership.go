@@ -0,0 +1,198 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/iam"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceAwsIamUserGroupMembership() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIamUserGroupMembershipCreate,
+		Read:   resourceAwsIamUserGroupMembershipRead,
+		Update: resourceAwsIamUserGroupMembershipUpdate,
+		Delete: resourceAwsIamUserGroupMembershipDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsIamUserGroupMembershipImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"user": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"groups": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+func resourceAwsIamUserGroupMembershipCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+
+	user := d.Get("user").(string)
+	groups := expandStringList(d.Get("groups").(*schema.Set).List())
+
+	if err := addUserToGroups(conn, aws.String(user), groups); err != nil {
+		return fmt.Errorf("error adding IAM User (%s) to groups: %s", user, err)
+	}
+
+	groupNames := make([]string, len(groups))
+	for i, g := range groups {
+		groupNames[i] = *g
+	}
+	d.SetId(fmt.Sprintf("%s/%s", user, strings.Join(groupNames, "/")))
+
+	if err := d.Set("user", user); err != nil {
+		return fmt.Errorf("error setting user: %s", err)
+	}
+
+	return resourceAwsIamUserGroupMembershipRead(d, meta)
+}
+
+func resourceAwsIamUserGroupMembershipRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+
+	user := d.Get("user").(string)
+	tracked := expandStringList(d.Get("groups").(*schema.Set).List())
+	trackedSet := make(map[string]bool, len(tracked))
+	for _, g := range tracked {
+		trackedSet[*g] = true
+	}
+
+	var groups []string
+	input := &iam.ListGroupsForUserInput{
+		UserName: aws.String(user),
+	}
+
+	err := conn.ListGroupsForUserPages(input, func(page *iam.ListGroupsForUserOutput, lastPage bool) bool {
+		for _, g := range page.Groups {
+			if trackedSet[*g.GroupName] {
+				groups = append(groups, *g.GroupName)
+			}
+		}
+		return !lastPage
+	})
+
+	if err != nil {
+		if isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
+			log.Printf("[WARN] IAM User (%s) not found, removing from state", user)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading IAM User (%s) group memberships: %s", user, err)
+	}
+
+	if err := d.Set("groups", groups); err != nil {
+		return fmt.Errorf("error setting groups: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsIamUserGroupMembershipUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+	user := d.Get("user").(string)
+
+	if d.HasChange("groups") {
+		o, n := d.GetChange("groups")
+		if o == nil {
+			o = new(schema.Set)
+		}
+		if n == nil {
+			n = new(schema.Set)
+		}
+
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+		remove := expandStringList(os.Difference(ns).List())
+		add := expandStringList(ns.Difference(os).List())
+
+		if err := removeUserFromGroups(conn, aws.String(user), remove); err != nil {
+			return fmt.Errorf("error removing IAM User (%s) from groups: %s", user, err)
+		}
+
+		if err := addUserToGroups(conn, aws.String(user), add); err != nil {
+			return fmt.Errorf("error adding IAM User (%s) to groups: %s", user, err)
+		}
+	}
+
+	return resourceAwsIamUserGroupMembershipRead(d, meta)
+}
+
+func resourceAwsIamUserGroupMembershipDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+
+	user := d.Get("user").(string)
+	groups := expandStringList(d.Get("groups").(*schema.Set).List())
+
+	if err := removeUserFromGroups(conn, aws.String(user), groups); err != nil {
+		return fmt.Errorf("error removing IAM User (%s) from groups: %s", user, err)
+	}
+
+	return nil
+}
+
+func addUserToGroups(conn *iam.IAM, user *string, groups []*string) error {
+	for _, g := range groups {
+		_, err := conn.AddUserToGroup(&iam.AddUserToGroupInput{
+			UserName:  user,
+			GroupName: g,
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func removeUserFromGroups(conn *iam.IAM, user *string, groups []*string) error {
+	for _, g := range groups {
+		_, err := conn.RemoveUserFromGroup(&iam.RemoveUserFromGroupInput{
+			UserName:  user,
+			GroupName: g,
+		})
+
+		if err != nil {
+			if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == iam.ErrCodeNoSuchEntityException {
+				continue
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+func resourceAwsIamUserGroupMembershipImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), "/")
+	if len(idParts) < 2 {
+		return nil, fmt.Errorf("unexpected format of ID (%q), expected <user-name>/<group-name>", d.Id())
+	}
+
+	user := idParts[0]
+	groups := idParts[1:]
+
+	if err := d.Set("user", user); err != nil {
+		return nil, fmt.Errorf("error setting user: %s", err)
+	}
+	if err := d.Set("groups", groups); err != nil {
+		return nil, fmt.Errorf("error setting groups: %s", err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", user, strings.Join(groups, "/")))
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,268 @@
+package aws
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccAWSIamUserGroupMembership_basic(t *testing.T) {
+	rString := acctest.RandString(8)
+	userName := fmt.Sprintf("tf-acc-user-ugm-basic-%s", rString)
+	groupName := fmt.Sprintf("tf-acc-group-ugm-basic-%s", rString)
+	resourceName := "aws_iam_user_group_membership.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSUserGroupMembershipDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSUserGroupMembershipConfig(userName, []string{groupName}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSUserGroupMembershipExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "groups.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// TestAccAWSIamUserGroupMembership_update exercises the add/remove diffing
+// in resourceAwsIamUserGroupMembershipUpdate by growing a user's tracked
+// groups from one to two and then shrinking back to a disjoint one.
+func TestAccAWSIamUserGroupMembership_update(t *testing.T) {
+	rString := acctest.RandString(8)
+	userName := fmt.Sprintf("tf-acc-user-ugm-update-%s", rString)
+	resourceName := "aws_iam_user_group_membership.test"
+
+	group1 := fmt.Sprintf("tf-acc-group-ugm-update-1-%s", rString)
+	group2 := fmt.Sprintf("tf-acc-group-ugm-update-2-%s", rString)
+	group3 := fmt.Sprintf("tf-acc-group-ugm-update-3-%s", rString)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSUserGroupMembershipDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSUserGroupMembershipConfig(userName, []string{group1}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSUserGroupMembershipExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "groups.#", "1"),
+				),
+			},
+			{
+				Config: testAccAWSUserGroupMembershipConfig(userName, []string{group1, group2}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSUserGroupMembershipExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "groups.#", "2"),
+				),
+			},
+			{
+				Config: testAccAWSUserGroupMembershipConfig(userName, []string{group3}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSUserGroupMembershipExists(resourceName),
+					testAccCheckAWSUserNotInGroup(userName, group1),
+					testAccCheckAWSUserNotInGroup(userName, group2),
+					resource.TestCheckResourceAttr(resourceName, "groups.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccAWSIamUserGroupMembership_externalGroupDrift confirms that a group
+// membership the user holds outside of the resource's tracked "groups" set
+// is ignored by resourceAwsIamUserGroupMembershipRead and does not appear
+// as drift.
+func TestAccAWSIamUserGroupMembership_externalGroupDrift(t *testing.T) {
+	rString := acctest.RandString(8)
+	userName := fmt.Sprintf("tf-acc-user-ugm-drift-%s", rString)
+	trackedGroup := fmt.Sprintf("tf-acc-group-ugm-drift-tracked-%s", rString)
+	externalGroup := fmt.Sprintf("tf-acc-group-ugm-drift-external-%s", rString)
+	resourceName := "aws_iam_user_group_membership.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSUserGroupMembershipDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSUserGroupMembershipConfigExternalGroup(userName, trackedGroup, externalGroup),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSUserGroupMembershipExists(resourceName),
+					testAccCheckAWSUserInGroup(userName, externalGroup),
+					resource.TestCheckResourceAttr(resourceName, "groups.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSUserGroupMembershipDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iam_user_group_membership" {
+			continue
+		}
+
+		user := rs.Primary.Attributes["user"]
+		tracked := rs.Primary.Attributes["groups.#"]
+		if tracked == "" {
+			continue
+		}
+
+		for k, v := range rs.Primary.Attributes {
+			if k == "groups.#" || !strings.HasPrefix(k, "groups.") {
+				continue
+			}
+			if err := testAccCheckAWSUserNotInGroup(user, v)(s); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSUserGroupMembershipExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+		_, err := iamconn.GetUser(&iam.GetUserInput{
+			UserName: aws.String(rs.Primary.Attributes["user"]),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAWSUserInGroup(userName, groupName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+
+		found := false
+		err := iamconn.ListGroupsForUserPages(&iam.ListGroupsForUserInput{
+			UserName: aws.String(userName),
+		}, func(page *iam.ListGroupsForUserOutput, lastPage bool) bool {
+			for _, g := range page.Groups {
+				if aws.StringValue(g.GroupName) == groupName {
+					found = true
+				}
+			}
+			return !lastPage
+		})
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("expected IAM User (%s) to be a member of group (%s)", userName, groupName)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSUserNotInGroup(userName, groupName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+
+		found := false
+		err := iamconn.ListGroupsForUserPages(&iam.ListGroupsForUserInput{
+			UserName: aws.String(userName),
+		}, func(page *iam.ListGroupsForUserOutput, lastPage bool) bool {
+			for _, g := range page.Groups {
+				if aws.StringValue(g.GroupName) == groupName {
+					found = true
+					return false
+				}
+			}
+			return !lastPage
+		})
+		if err != nil {
+			if isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
+				return nil
+			}
+			return err
+		}
+		if found {
+			return fmt.Errorf("expected IAM User (%s) not to be a member of group (%s)", userName, groupName)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSUserGroupMembershipConfig(userName string, groups []string) string {
+	var groupConfig string
+	groupRefs := make([]string, len(groups))
+	for i, g := range groups {
+		groupConfig += fmt.Sprintf(`
+resource "aws_iam_group" "test%[1]d" {
+  name = %[2]q
+}
+`, i, g)
+		groupRefs[i] = fmt.Sprintf("aws_iam_group.test%d.name", i)
+	}
+
+	return fmt.Sprintf(`
+resource "aws_iam_user" "test" {
+  name = %[1]q
+}
+
+%[2]s
+
+resource "aws_iam_user_group_membership" "test" {
+  user   = aws_iam_user.test.name
+  groups = [%[3]s]
+}
+`, userName, groupConfig, strings.Join(groupRefs, ", "))
+}
+
+func testAccAWSUserGroupMembershipConfigExternalGroup(userName, trackedGroup, externalGroup string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_user" "test" {
+  name = %[1]q
+}
+
+resource "aws_iam_group" "tracked" {
+  name = %[2]q
+}
+
+resource "aws_iam_group" "external" {
+  name = %[3]q
+}
+
+resource "aws_iam_group_membership" "external" {
+  name  = %[3]q
+  group = aws_iam_group.external.name
+  users = [aws_iam_user.test.name]
+}
+
+resource "aws_iam_user_group_membership" "test" {
+  user   = aws_iam_user.test.name
+  groups = [aws_iam_group.tracked.name]
+
+  depends_on = [aws_iam_group_membership.external]
+}
+`, userName, trackedGroup, externalGroup)
+}